@@ -0,0 +1,133 @@
+// Command api starts the User Management API server.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"user-management-api/pkg/server"
+	"user-management-api/pkg/store/memory"
+	"user-management-api/pkg/store/postgres"
+	"user-management-api/pkg/user"
+)
+
+// shutdownTimeout bounds how long in-flight requests get to drain once a
+// shutdown signal arrives.
+const shutdownTimeout = 30 * time.Second
+
+var (
+	storageFlag = flag.String("storage", "memory", "storage backend to use: memory or postgres")
+	postgresDSN = flag.String("postgres-dsn", "", "Postgres connection string, required when --storage=postgres")
+	migrateIDs  = flag.Bool("migrate-ids", false, "rewrite legacy integer IDs to UUIDs at startup (postgres backend only)")
+)
+
+// newUserService constructs the storage backend selected by --storage.
+func newUserService() user.Service {
+	switch *storageFlag {
+	case "memory":
+		return memory.New()
+	case "postgres":
+		db, err := sql.Open("postgres", *postgresDSN)
+		if err != nil {
+			log.Fatalf("failed to open postgres connection: %v", err)
+		}
+		store, err := postgres.New(db)
+		if err != nil {
+			log.Fatalf("failed to initialize postgres store: %v", err)
+		}
+		return store
+	default:
+		log.Fatalf("unknown storage backend: %s", *storageFlag)
+		return nil
+	}
+}
+
+// populateTestData seeds the service with a handful of demo users.
+func populateTestData(userService user.Service) {
+	users := []*user.User{
+		{
+			Username:  "johndoe",
+			Email:     "john@example.com",
+			Password:  "changeme123",
+			FirstName: "John",
+			LastName:  "Doe",
+			Roles:     []string{"user"},
+		},
+		{
+			Username:  "janesmith",
+			Email:     "jane@example.com",
+			Password:  "changeme123",
+			FirstName: "Jane",
+			LastName:  "Smith",
+			Roles:     []string{"user", "admin"},
+		},
+		{
+			Username:  "bobwilson",
+			Email:     "bob@example.com",
+			Password:  "changeme123",
+			FirstName: "Bob",
+			LastName:  "Wilson",
+			Roles:     []string{"user"},
+		},
+	}
+
+	for _, u := range users {
+		if _, err := userService.CreateUser(u); err != nil {
+			log.Printf("Error creating test user %s: %v", u.Username, err)
+		} else {
+			log.Printf("Created test user: %s", u.Username)
+		}
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	log.Println("Starting User Management API...")
+
+	userService := newUserService()
+	populateTestData(userService)
+
+	if *migrateIDs {
+		if ps, ok := userService.(*postgres.Store); ok {
+			log.Println("Migrating legacy integer IDs to UUIDs...")
+			if err := ps.MigrateIDs(); err != nil {
+				log.Fatalf("failed to migrate legacy IDs: %v", err)
+			}
+		} else {
+			log.Println("--migrate-ids has no effect on this storage backend")
+		}
+	}
+
+	srv := server.New(userService)
+	srv.StartCleanupTask()
+
+	go func() {
+		log.Println("Server is ready to accept connections")
+		if err := srv.Start(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Server failed to start: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Fatalf("graceful shutdown failed: %v", err)
+	}
+
+	log.Println("Server stopped")
+}
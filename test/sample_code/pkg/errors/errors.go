@@ -0,0 +1,151 @@
+// Package errors provides typed API errors and a central WriteError that
+// turns them into the structured JSON error envelope the API returns.
+package errors
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// FieldError describes a single failing field in a validation error.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErr aggregates every failing field found in one validation pass,
+// so callers get complete feedback in a single round trip instead of one
+// error at a time.
+type ValidationErr struct {
+	Fields []FieldError
+}
+
+// ValidationError builds a single-field validation error. Combine several
+// with NewValidationErrors to report multiple failing fields at once.
+func ValidationError(field, message string) *ValidationErr {
+	return &ValidationErr{Fields: []FieldError{{Field: field, Message: message}}}
+}
+
+// NewValidationErrors aggregates field errors collected during validation.
+// Returns nil if fields is empty, so callers can use it directly as the
+// return value of a validation pass.
+func NewValidationErrors(fields []FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationErr{Fields: fields}
+}
+
+func (e *ValidationErr) Error() string {
+	if len(e.Fields) == 0 {
+		return "validation error"
+	}
+	return e.Fields[0].Field + ": " + e.Fields[0].Message
+}
+
+// NotFoundErr indicates the requested resource does not exist.
+type NotFoundErr struct {
+	Message string
+}
+
+// NotFoundError builds a NotFoundErr with the given message.
+func NotFoundError(message string) error {
+	return &NotFoundErr{Message: message}
+}
+
+func (e *NotFoundErr) Error() string { return e.Message }
+
+// ConflictErr indicates the request conflicts with existing state, e.g. a
+// duplicate email or username.
+type ConflictErr struct {
+	Message string
+}
+
+// ConflictError builds a ConflictErr with the given message.
+func ConflictError(message string) error {
+	return &ConflictErr{Message: message}
+}
+
+func (e *ConflictErr) Error() string { return e.Message }
+
+// UnauthorizedErr indicates the request lacks valid authentication.
+type UnauthorizedErr struct {
+	Message string
+}
+
+// UnauthorizedError builds an UnauthorizedErr with the given message.
+func UnauthorizedError(message string) error {
+	return &UnauthorizedErr{Message: message}
+}
+
+func (e *UnauthorizedErr) Error() string { return e.Message }
+
+// ForbiddenErr indicates the authenticated caller lacks permission to act.
+type ForbiddenErr struct {
+	Message string
+}
+
+// ForbiddenError builds a ForbiddenErr with the given message.
+func ForbiddenError(message string) error {
+	return &ForbiddenErr{Message: message}
+}
+
+func (e *ForbiddenErr) Error() string { return e.Message }
+
+// MethodNotAllowedErr indicates the route exists but not for this HTTP method.
+type MethodNotAllowedErr struct {
+	Message string
+}
+
+// MethodNotAllowedError builds a MethodNotAllowedErr with the given message.
+func MethodNotAllowedError(message string) error {
+	return &MethodNotAllowedErr{Message: message}
+}
+
+func (e *MethodNotAllowedErr) Error() string { return e.Message }
+
+// body is the wire shape of the "error" field in the JSON response envelope.
+type body struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message,omitempty"`
+	Fields  []FieldError `json:"fields,omitempty"`
+}
+
+type envelope struct {
+	Success bool `json:"success"`
+	Error   body `json:"error"`
+}
+
+// WriteError inspects the error's type, picks the matching HTTP status, and
+// writes the structured JSON error envelope.
+func WriteError(w http.ResponseWriter, err error) {
+	status, resp := toResponse(err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func toResponse(err error) (int, envelope) {
+	switch e := err.(type) {
+	case *ValidationErr:
+		return http.StatusBadRequest, envelope{Error: body{Code: "validation_error", Fields: e.Fields}}
+	case *NotFoundErr:
+		return http.StatusNotFound, envelope{Error: body{Code: "not_found", Message: e.Message}}
+	case *ConflictErr:
+		return http.StatusConflict, envelope{Error: body{Code: "conflict", Message: e.Message}}
+	case *UnauthorizedErr:
+		return http.StatusUnauthorized, envelope{Error: body{Code: "unauthorized", Message: e.Message}}
+	case *ForbiddenErr:
+		return http.StatusForbidden, envelope{Error: body{Code: "forbidden", Message: e.Message}}
+	case *MethodNotAllowedErr:
+		return http.StatusMethodNotAllowed, envelope{Error: body{Code: "method_not_allowed", Message: e.Message}}
+	default:
+		// Unrecognized errors (e.g. a raw *sql.DB/driver failure from
+		// pkg/store/postgres) may carry details callers shouldn't see, so
+		// log the real error server-side and return a generic message.
+		log.Printf("internal error: %v", err)
+		return http.StatusInternalServerError, envelope{Error: body{Code: "internal_error", Message: "an internal error occurred"}}
+	}
+}
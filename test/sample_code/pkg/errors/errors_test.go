@@ -0,0 +1,24 @@
+package errors
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestWriteErrorHidesUnrecognizedErrorMessages verifies that an error type
+// WriteError doesn't recognize (e.g. a raw driver error from pkg/store/postgres)
+// never has its message forwarded to the client.
+func TestWriteErrorHidesUnrecognizedErrorMessages(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	WriteError(rec, errors.New("pq: password authentication failed for user \"app\""))
+
+	if rec.Code != 500 {
+		t.Fatalf("expected 500, got %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "password authentication failed") {
+		t.Fatalf("expected the raw error message to be hidden from the client, got body: %s", rec.Body.String())
+	}
+}
@@ -0,0 +1,283 @@
+// Package httpapi wires up HTTP handlers and middleware on top of a
+// user.Service, independent of which storage backend is behind it.
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/crypto/bcrypt"
+
+	apierrors "user-management-api/pkg/errors"
+	"user-management-api/pkg/user"
+)
+
+// Handler handles HTTP requests against a user.Service
+type Handler struct {
+	userService  user.Service
+	sessionStore *SessionStore
+}
+
+// NewHandler creates a new HTTP handler backed by the given user service.
+func NewHandler(userService user.Service, sessionStore *SessionStore) *Handler {
+	return &Handler{userService: userService, sessionStore: sessionStore}
+}
+
+// Response represents a standard HTTP success response envelope. Errors use
+// the structured envelope written by apierrors.WriteError instead.
+type Response struct {
+	Success bool        `json:"success"`
+	Data    interface{} `json:"data,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// AuthRequest is the payload expected by POST /auth
+type AuthRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// AuthResponse carries the bearer token minted for a successful login
+type AuthResponse struct {
+	Token string     `json:"token"`
+	User  *user.User `json:"user"`
+}
+
+// AuthHandler handles POST /auth, exchanging credentials for a session token
+func (h *Handler) AuthHandler(w http.ResponseWriter, r *http.Request) {
+	var req AuthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("body", "must be valid JSON"))
+		return
+	}
+
+	u, err := h.userService.FindByUsername(req.Username)
+	if err != nil {
+		apierrors.WriteError(w, apierrors.UnauthorizedError("invalid username or password"))
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)); err != nil {
+		apierrors.WriteError(w, apierrors.UnauthorizedError("invalid username or password"))
+		return
+	}
+
+	session, err := h.sessionStore.Create(u.ID)
+	if err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, AuthResponse{Token: session.Token, User: u}, "Authenticated successfully")
+}
+
+// LogoutHandler handles POST /logout, revoking the caller's session token
+func (h *Handler) LogoutHandler(w http.ResponseWriter, r *http.Request) {
+	token := bearerToken(r)
+	if token == "" {
+		apierrors.WriteError(w, apierrors.ValidationError("authorization", "missing bearer token"))
+		return
+	}
+
+	h.sessionStore.Revoke(token)
+	h.sendSuccessResponse(w, http.StatusOK, nil, "Logged out successfully")
+}
+
+// CreateUserHandler handles POST /users. Open to anonymous callers so
+// accounts can self-register.
+func (h *Handler) CreateUserHandler(w http.ResponseWriter, r *http.Request) {
+	var u user.User
+	if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("body", "must be valid JSON"))
+		return
+	}
+
+	// Only an already-authenticated admin may set roles on the new account;
+	// anyone else (including an anonymous self-registration) gets the
+	// default "user" role regardless of what the body asked for.
+	if authedUser, ok := userFromContext(r.Context()); !ok || !authedUser.HasRole("admin") {
+		u.Roles = []string{"user"}
+	}
+
+	created, err := h.userService.CreateUser(&u)
+	if err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusCreated, created, "User created successfully")
+}
+
+// GetUserHandler handles GET /users/:id. The literal segment "search" is
+// special-cased to SearchUsersHandler, since it can't be registered as its
+// own route alongside the :id wildcard (see routes.go).
+func (h *Handler) GetUserHandler(w http.ResponseWriter, r *http.Request) {
+	if httprouter.ParamsFromContext(r.Context()).ByName("id") == "search" {
+		h.SearchUsersHandler(w, r)
+		return
+	}
+
+	userID, err := idFromRequest(r)
+	if err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("id", "must be a legacy integer ID or a UUID"))
+		return
+	}
+
+	u, err := h.userService.GetUser(userID)
+	if err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, u, "User retrieved successfully")
+}
+
+// UpdateUserHandler handles PUT /users/:id
+func (h *Handler) UpdateUserHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := idFromRequest(r)
+	if err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("id", "must be a legacy integer ID or a UUID"))
+		return
+	}
+
+	authedUser, ok := userFromContext(r.Context())
+	if !ok {
+		apierrors.WriteError(w, apierrors.UnauthorizedError("authentication required"))
+		return
+	}
+	if authedUser.ID != userID && !authedUser.HasRole("admin") {
+		apierrors.WriteError(w, apierrors.ForbiddenError("not authorized to update this user"))
+		return
+	}
+
+	var u user.User
+	if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("body", "must be valid JSON"))
+		return
+	}
+
+	// Only admins may change roles or active status. A non-admin updating
+	// their own profile has those fields pinned to their current values, so
+	// the self-or-admin check above can't be used to self-escalate.
+	if !authedUser.HasRole("admin") {
+		existing, err := h.userService.GetUser(userID)
+		if err != nil {
+			apierrors.WriteError(w, err)
+			return
+		}
+		u.Roles = existing.Roles
+		u.IsActive = existing.IsActive
+	}
+
+	updated, err := h.userService.UpdateUser(userID, &u)
+	if err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, updated, "User updated successfully")
+}
+
+// DeleteUserHandler handles DELETE /users/:id. Restricted to admins by RequireRole.
+func (h *Handler) DeleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := idFromRequest(r)
+	if err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("id", "must be a legacy integer ID or a UUID"))
+		return
+	}
+
+	if err := h.userService.DeleteUser(userID); err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, nil, "User deleted successfully")
+}
+
+// ListUsersHandler handles GET /users. Restricted to admins by RequireRole.
+func (h *Handler) ListUsersHandler(w http.ResponseWriter, r *http.Request) {
+	page := 1
+	limit := 10
+
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	users, err := h.userService.ListUsers(page-1, limit) // Convert to 0-based indexing
+	if err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, users, "Users retrieved successfully")
+}
+
+// SearchUsersHandler handles GET /users/search
+func (h *Handler) SearchUsersHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if strings.TrimSpace(query) == "" {
+		apierrors.WriteError(w, apierrors.ValidationError("q", "search query is required"))
+		return
+	}
+
+	users, err := h.userService.SearchUsers(query)
+	if err != nil {
+		apierrors.WriteError(w, err)
+		return
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, users, "Search completed successfully")
+}
+
+// HealthCheckHandler handles GET /health
+func (h *Handler) HealthCheckHandler(w http.ResponseWriter, r *http.Request) {
+	response := map[string]interface{}{
+		"status":    "healthy",
+		"timestamp": time.Now().Unix(),
+		"service":   "user-management-api",
+		"version":   "1.0.0",
+	}
+
+	h.sendSuccessResponse(w, http.StatusOK, response, "Service is healthy")
+}
+
+func (h *Handler) sendSuccessResponse(w http.ResponseWriter, statusCode int, data interface{}, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(Response{
+		Success: true,
+		Data:    data,
+		Message: message,
+	})
+}
+
+// idFromRequest reads the ":id" path parameter matched by the router. It
+// accepts both a legacy integer ID and a UUID, since the two schemes
+// coexist during the migration to UUIDs.
+func idFromRequest(r *http.Request) (string, error) {
+	id := httprouter.ParamsFromContext(r.Context()).ByName("id")
+
+	if _, err := strconv.Atoi(id); err == nil {
+		return id, nil
+	}
+	if _, err := uuid.Parse(id); err == nil {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("invalid id: %s", id)
+}
@@ -0,0 +1,84 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+
+	"user-management-api/pkg/store/memory"
+	"user-management-api/pkg/user"
+)
+
+// TestUpdateUserHandlerCannotSelfEscalate verifies that a non-admin updating
+// their own profile can't grant themselves roles or flip is_active, even
+// though the self-or-admin check lets the request through.
+func TestUpdateUserHandlerCannotSelfEscalate(t *testing.T) {
+	store := memory.New()
+	created, err := store.CreateUser(&user.User{
+		Username:  "alice",
+		Email:     "alice@example.com",
+		FirstName: "Alice",
+		LastName:  "Doe",
+		Roles:     []string{"user"},
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	h := NewHandler(store, NewSessionStore())
+
+	body := strings.NewReader(`{"username":"alice","email":"alice@example.com","first_name":"Alice","last_name":"Doe","roles":["admin"],"is_active":false}`)
+	req := httptest.NewRequest(http.MethodPut, "/users/"+created.ID, body)
+
+	ctx := context.WithValue(req.Context(), authUserContextKey, created)
+	ctx = context.WithValue(ctx, httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: created.ID}})
+	req = req.WithContext(ctx)
+
+	rec := httptest.NewRecorder()
+	h.UpdateUserHandler(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	updated, err := store.GetUser(created.ID)
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if updated.HasRole("admin") {
+		t.Fatal("non-admin self-update was able to grant the admin role")
+	}
+	if !updated.IsActive {
+		t.Fatal("non-admin self-update was able to flip is_active")
+	}
+}
+
+// TestCreateUserHandlerSelfRegistrationCannotGrantRoles verifies that an
+// anonymous caller registering a new account can't hand themselves roles,
+// even though POST /users has to be reachable without a bearer token.
+func TestCreateUserHandlerSelfRegistrationCannotGrantRoles(t *testing.T) {
+	store := memory.New()
+	h := NewHandler(store, NewSessionStore())
+
+	body := strings.NewReader(`{"username":"mallory","email":"mallory@example.com","first_name":"Mallory","last_name":"Doe","roles":["admin"]}`)
+	req := httptest.NewRequest(http.MethodPost, "/users", body)
+
+	rec := httptest.NewRecorder()
+	h.CreateUserHandler(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	created, err := store.FindByUsername("mallory")
+	if err != nil {
+		t.Fatalf("FindByUsername: %v", err)
+	}
+	if created.HasRole("admin") {
+		t.Fatal("anonymous self-registration was able to grant the admin role")
+	}
+}
@@ -0,0 +1,39 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"user-management-api/pkg/metrics"
+)
+
+// TestMetricsMiddlewareLabelsByRouteTemplate verifies requests against a
+// parameterized route are recorded under the route's registration template,
+// not the live request path, so distinct resource IDs don't each mint a new
+// Prometheus time series.
+func TestMetricsMiddlewareLabelsByRouteTemplate(t *testing.T) {
+	router := httprouter.New()
+	router.Handler(http.MethodGet, "/users/:id", withRoutePattern("/users/:id", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	handler := metricsMiddleware(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/11111111-1111-1111-1111-111111111111", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got := testutil.ToFloat64(metrics.RequestsTotal.WithLabelValues(http.MethodGet, "/users/:id", "200")); got != 1 {
+		t.Fatalf("expected 1 request recorded under the route template, got %v", got)
+	}
+
+	req2 := httptest.NewRequest(http.MethodGet, "/users/22222222-2222-2222-2222-222222222222", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req2)
+
+	if got := testutil.ToFloat64(metrics.RequestsTotal.WithLabelValues(http.MethodGet, "/users/:id", "200")); got != 2 {
+		t.Fatalf("expected a second, distinct-ID request to accumulate on the same route template series, got %v", got)
+	}
+}
@@ -0,0 +1,190 @@
+package httpapi
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "user-management-api/pkg/errors"
+	"user-management-api/pkg/metrics"
+	"user-management-api/pkg/user"
+)
+
+// contextKey is an unexported type so context values set by this package
+// can't collide with keys set elsewhere.
+type contextKey string
+
+const authUserContextKey contextKey = "authUser"
+const routePatternContextKey contextKey = "routePattern"
+
+// unmatchedRoute labels requests that never reached a registered route
+// (404s, CORS preflight, etc), so they don't fall back to the live path.
+const unmatchedRoute = "unmatched"
+
+// userFromContext retrieves the authenticated user injected by authMiddleware
+func userFromContext(ctx context.Context) (*user.User, bool) {
+	u, ok := ctx.Value(authUserContextKey).(*user.User)
+	return u, ok
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(header, prefix)
+}
+
+// authMiddleware validates the bearer token on every request and injects the
+// authenticated user into the request context for downstream handlers.
+func authMiddleware(sessionStore *SessionStore, userService user.Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := bearerToken(r)
+			if token == "" {
+				apierrors.WriteError(w, apierrors.UnauthorizedError("missing bearer token"))
+				return
+			}
+
+			session, ok := sessionStore.Validate(token)
+			if !ok {
+				apierrors.WriteError(w, apierrors.UnauthorizedError("invalid or expired session"))
+				return
+			}
+
+			authedUser, err := userService.GetUser(session.UserID)
+			if err != nil {
+				apierrors.WriteError(w, apierrors.UnauthorizedError("user not found"))
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), authUserContextKey, authedUser)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// optionalAuthMiddleware injects the authenticated user into the request
+// context when a valid bearer token is present, but — unlike authMiddleware
+// — lets the request through when it's missing or invalid. Used by routes
+// that anonymous callers must be able to reach but that still behave
+// differently for an authenticated admin caller.
+func optionalAuthMiddleware(sessionStore *SessionStore, userService user.Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := bearerToken(r)
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session, ok := sessionStore.Validate(token)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			authedUser, err := userService.GetUser(session.UserID)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), authUserContextKey, authedUser)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireRole returns middleware that rejects requests whose authenticated
+// user (injected by authMiddleware) does not hold the given role.
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			u, ok := userFromContext(r.Context())
+			if !ok || !u.HasRole(role) {
+				apierrors.WriteError(w, apierrors.ForbiddenError("insufficient permissions"))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// withRoutePattern tags a registered handler with its route template (e.g.
+// "/users/:id") so metricsMiddleware can label metrics by that template
+// instead of the live request path, which would otherwise create a new time
+// series per distinct resource ID.
+func withRoutePattern(pattern string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route, ok := r.Context().Value(routePatternContextKey).(*string); ok {
+			*route = pattern
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// loggingMiddleware logs every request and how long it took to handle
+func loggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		log.Printf("Request: %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
+
+		next.ServeHTTP(w, r)
+
+		duration := time.Since(start)
+		log.Printf("Response: %s %s completed in %v", r.Method, r.URL.Path, duration)
+	})
+}
+
+// statusRecorder wraps a ResponseWriter to capture the status code written,
+// since http.ResponseWriter doesn't expose it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+// metricsMiddleware records request counts and latency to Prometheus,
+// labeled by the matched route template rather than the live path so
+// per-resource requests (e.g. /users/<uuid>) don't each mint a new series.
+func metricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		route := unmatchedRoute
+		ctx := context.WithValue(r.Context(), routePatternContextKey, &route)
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		duration := time.Since(start)
+		metrics.RequestDuration.WithLabelValues(r.Method, route).Observe(duration.Seconds())
+		metrics.RequestsTotal.WithLabelValues(r.Method, route, strconv.Itoa(rec.status)).Inc()
+	})
+}
+
+// corsMiddleware sets permissive CORS headers for browser-based clients
+func corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
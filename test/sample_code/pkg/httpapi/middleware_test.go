@@ -0,0 +1,126 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"user-management-api/pkg/store/memory"
+	"user-management-api/pkg/user"
+)
+
+func noopHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// TestAuthMiddlewareMissingToken verifies a request with no Authorization
+// header is rejected before reaching the wrapped handler.
+func TestAuthMiddlewareMissingToken(t *testing.T) {
+	store := memory.New()
+	mw := authMiddleware(NewSessionStore(), store)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	rec := httptest.NewRecorder()
+
+	mw(noopHandler()).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+// TestAuthMiddlewareGarbageToken verifies a bearer token that was never
+// issued by the SessionStore is rejected.
+func TestAuthMiddlewareGarbageToken(t *testing.T) {
+	store := memory.New()
+	mw := authMiddleware(NewSessionStore(), store)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	req.Header.Set("Authorization", "Bearer not-a-real-token")
+	rec := httptest.NewRecorder()
+
+	mw(noopHandler()).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+// TestAuthMiddlewareValidToken verifies a valid bearer token injects the
+// authenticated user into the request context and lets the request through.
+func TestAuthMiddlewareValidToken(t *testing.T) {
+	store := memory.New()
+	created, err := store.CreateUser(&user.User{
+		Username:  "alice",
+		Email:     "alice@example.com",
+		FirstName: "Alice",
+		LastName:  "Doe",
+		Roles:     []string{"user"},
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	sessions := NewSessionStore()
+	session, err := sessions.Create(created.ID)
+	if err != nil {
+		t.Fatalf("Create session: %v", err)
+	}
+
+	var sawUser *user.User
+	mw := authMiddleware(sessions, store)
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sawUser, _ = userFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+created.ID, nil)
+	req.Header.Set("Authorization", "Bearer "+session.Token)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if sawUser == nil || sawUser.ID != created.ID {
+		t.Fatal("expected the authenticated user to be injected into the context")
+	}
+}
+
+// TestRequireRoleBlocksNonAdmin verifies RequireRole rejects an
+// authenticated user who doesn't hold the required role.
+func TestRequireRoleBlocksNonAdmin(t *testing.T) {
+	nonAdmin := &user.User{ID: "1", Roles: []string{"user"}}
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	ctx := context.WithValue(req.Context(), authUserContextKey, nonAdmin)
+	req = req.WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	RequireRole("admin")(noopHandler()).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", rec.Code)
+	}
+}
+
+// TestRequireRoleAllowsAdmin verifies RequireRole lets a request through
+// when the authenticated user holds the required role.
+func TestRequireRoleAllowsAdmin(t *testing.T) {
+	admin := &user.User{ID: "1", Roles: []string{"user", "admin"}}
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	ctx := context.WithValue(req.Context(), authUserContextKey, admin)
+	req = req.WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	RequireRole("admin")(noopHandler()).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+}
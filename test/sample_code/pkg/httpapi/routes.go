@@ -0,0 +1,53 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	apierrors "user-management-api/pkg/errors"
+	"user-management-api/pkg/user"
+)
+
+// NewRouter builds the HTTP handler for the API, wiring method-aware routes,
+// auth, and the logging/CORS middleware chain around them.
+func NewRouter(userService user.Service, sessionStore *SessionStore) http.Handler {
+	handler := NewHandler(userService, sessionStore)
+	requireAuth := authMiddleware(sessionStore, userService)
+	optionalAuth := optionalAuthMiddleware(sessionStore, userService)
+
+	router := httprouter.New()
+	router.NotFound = http.HandlerFunc(notFoundHandler)
+	router.MethodNotAllowed = http.HandlerFunc(methodNotAllowedHandler)
+
+	router.Handler(http.MethodPost, "/auth", withRoutePattern("/auth", http.HandlerFunc(handler.AuthHandler)))
+	router.Handler(http.MethodPost, "/logout", withRoutePattern("/logout", http.HandlerFunc(handler.LogoutHandler)))
+
+	// POST /users is reachable anonymously so accounts can self-register;
+	// optionalAuth still recognizes an authenticated admin caller so
+	// CreateUserHandler can tell "self-registration" from "admin creating a
+	// user on someone's behalf" and gate the roles field accordingly.
+	router.Handler(http.MethodPost, "/users", withRoutePattern("/users", optionalAuth(http.HandlerFunc(handler.CreateUserHandler))))
+	router.Handler(http.MethodGet, "/users", withRoutePattern("/users", requireAuth(RequireRole("admin")(http.HandlerFunc(handler.ListUsersHandler)))))
+	// "/users/search" is handled by GetUserHandler, not registered as its own
+	// route: httprouter can't register a static sibling ("search") alongside
+	// a wildcard (":id") at the same path depth, so it panics at startup if
+	// both are registered here.
+	router.Handler(http.MethodGet, "/users/:id", withRoutePattern("/users/:id", requireAuth(http.HandlerFunc(handler.GetUserHandler))))
+	router.Handler(http.MethodPut, "/users/:id", withRoutePattern("/users/:id", requireAuth(http.HandlerFunc(handler.UpdateUserHandler))))
+	router.Handler(http.MethodDelete, "/users/:id", withRoutePattern("/users/:id", requireAuth(RequireRole("admin")(http.HandlerFunc(handler.DeleteUserHandler)))))
+
+	router.Handler(http.MethodGet, "/health", withRoutePattern("/health", http.HandlerFunc(handler.HealthCheckHandler)))
+	router.Handler(http.MethodGet, "/metrics", withRoutePattern("/metrics", promhttp.Handler()))
+
+	return metricsMiddleware(loggingMiddleware(corsMiddleware(router)))
+}
+
+func notFoundHandler(w http.ResponseWriter, r *http.Request) {
+	apierrors.WriteError(w, apierrors.NotFoundError("route not found"))
+}
+
+func methodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	apierrors.WriteError(w, apierrors.MethodNotAllowedError("method not allowed"))
+}
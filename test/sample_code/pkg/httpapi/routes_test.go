@@ -0,0 +1,21 @@
+package httpapi
+
+import (
+	"testing"
+
+	"user-management-api/pkg/store/memory"
+)
+
+// TestNewRouterDoesNotPanic guards against route registrations that conflict
+// on httprouter's method tree (e.g. a static sibling alongside a wildcard at
+// the same path depth), which panics at construction time rather than
+// failing a request.
+func TestNewRouterDoesNotPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("NewRouter panicked: %v", r)
+		}
+	}()
+
+	NewRouter(memory.New(), NewSessionStore())
+}
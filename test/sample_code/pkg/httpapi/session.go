@@ -0,0 +1,94 @@
+package httpapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"user-management-api/pkg/metrics"
+)
+
+// Session represents a single authenticated bearer-token session
+type Session struct {
+	Token     string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// sessionTTL controls how long a minted session token remains valid
+const sessionTTL = 24 * time.Hour
+
+// SessionStore mints and validates opaque bearer tokens
+type SessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+}
+
+// NewSessionStore creates an empty session store
+func NewSessionStore() *SessionStore {
+	return &SessionStore{
+		sessions: make(map[string]*Session),
+	}
+}
+
+// Create mints a new opaque token for the given user and records its session
+func (s *SessionStore) Create(userID string) (*Session, error) {
+	token, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session token: %w", err)
+	}
+
+	session := &Session{
+		Token:     token,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(sessionTTL),
+	}
+
+	s.mu.Lock()
+	s.sessions[token] = session
+	s.mu.Unlock()
+	metrics.ActiveSessions.Inc()
+
+	return session, nil
+}
+
+// Validate returns the session for a token if it exists and has not expired
+func (s *SessionStore) Validate(token string) (*Session, bool) {
+	s.mu.RLock()
+	session, exists := s.sessions[token]
+	s.mu.RUnlock()
+
+	if !exists {
+		return nil, false
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		s.Revoke(token)
+		return nil, false
+	}
+
+	return session, true
+}
+
+// Revoke invalidates a session token, e.g. on logout
+func (s *SessionStore) Revoke(token string) {
+	s.mu.Lock()
+	_, existed := s.sessions[token]
+	delete(s.sessions, token)
+	s.mu.Unlock()
+
+	if existed {
+		metrics.ActiveSessions.Dec()
+	}
+}
+
+// generateToken produces a random 32-byte opaque token, hex-encoded
+func generateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
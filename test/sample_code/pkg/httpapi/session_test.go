@@ -0,0 +1,79 @@
+package httpapi
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSessionStoreCreateAndValidate verifies a freshly minted token
+// validates back to the session it was created for.
+func TestSessionStoreCreateAndValidate(t *testing.T) {
+	store := NewSessionStore()
+
+	session, err := store.Create("user-1")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if session.Token == "" {
+		t.Fatal("expected a non-empty token")
+	}
+
+	got, ok := store.Validate(session.Token)
+	if !ok {
+		t.Fatal("expected token to validate")
+	}
+	if got.UserID != "user-1" {
+		t.Fatalf("expected UserID user-1, got %s", got.UserID)
+	}
+}
+
+// TestSessionStoreValidateUnknownToken verifies a token that was never
+// issued doesn't validate.
+func TestSessionStoreValidateUnknownToken(t *testing.T) {
+	store := NewSessionStore()
+
+	if _, ok := store.Validate("not-a-real-token"); ok {
+		t.Fatal("expected unknown token to fail validation")
+	}
+}
+
+// TestSessionStoreValidateExpiredToken verifies a session past its
+// ExpiresAt is rejected and removed from the store.
+func TestSessionStoreValidateExpiredToken(t *testing.T) {
+	store := NewSessionStore()
+	session, err := store.Create("user-1")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	store.mu.Lock()
+	store.sessions[session.Token].ExpiresAt = time.Now().Add(-time.Minute)
+	store.mu.Unlock()
+
+	if _, ok := store.Validate(session.Token); ok {
+		t.Fatal("expected expired token to fail validation")
+	}
+
+	store.mu.RLock()
+	_, stillPresent := store.sessions[session.Token]
+	store.mu.RUnlock()
+	if stillPresent {
+		t.Fatal("expected expired session to be removed from the store")
+	}
+}
+
+// TestSessionStoreRevokeThenReuse verifies a revoked token can't be used
+// again.
+func TestSessionStoreRevokeThenReuse(t *testing.T) {
+	store := NewSessionStore()
+	session, err := store.Create("user-1")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	store.Revoke(session.Token)
+
+	if _, ok := store.Validate(session.Token); ok {
+		t.Fatal("expected revoked token to fail validation")
+	}
+}
@@ -0,0 +1,75 @@
+// Package metrics registers the Prometheus collectors exposed by the API
+// and is consumed by pkg/httpapi to instrument every request.
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// RequestsTotal counts every request handled, labeled by method, route, and status.
+	RequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests handled, labeled by method, route, and status code.",
+		},
+		[]string{"method", "route", "status"},
+	)
+
+	// RequestDuration observes how long each request took to handle.
+	RequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Latency of HTTP requests in seconds, labeled by method and route.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "route"},
+	)
+
+	// ActiveSessions tracks the current number of live bearer-token sessions.
+	ActiveSessions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "active_sessions",
+		Help: "Current number of active authenticated sessions.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RequestsTotal, RequestDuration, ActiveSessions)
+}
+
+// userCounter is satisfied by user.Service; kept narrow so this package
+// doesn't need to import pkg/user just to report a count.
+type userCounter interface {
+	CountUsers() (int, error)
+}
+
+// RegisterUsersTotal wires a users_total gauge that recomputes its value
+// from the store on every scrape, via CountUsers, rather than being
+// maintained as a delta counter. A delta counter would drift from reality
+// whenever users are added outside the HTTP handlers, e.g. seed data or
+// pre-existing rows in a Postgres database at startup.
+func RegisterUsersTotal(users userCounter) {
+	gauge := prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "users_total",
+			Help: "Current number of users known to the service.",
+		},
+		func() float64 {
+			count, err := users.CountUsers()
+			if err != nil {
+				return 0
+			}
+			return float64(count)
+		},
+	)
+
+	// Register is used instead of MustRegister since server.New (and
+	// therefore this) may run more than once in the same process, e.g.
+	// across tests.
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if err := prometheus.Register(gauge); err != nil && !errors.As(err, &alreadyRegistered) {
+		panic(err)
+	}
+}
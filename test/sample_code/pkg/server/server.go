@@ -0,0 +1,76 @@
+// Package server assembles the HTTP API into a runnable server.
+package server
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"user-management-api/pkg/httpapi"
+	"user-management-api/pkg/metrics"
+	"user-management-api/pkg/user"
+)
+
+// Server represents the HTTP server
+type Server struct {
+	httpServer   *http.Server
+	userService  user.Service
+	sessionStore *httpapi.SessionStore
+	cleanupQuit  chan struct{}
+}
+
+// New creates a new server instance wired to the given user service.
+func New(userService user.Service) *Server {
+	sessionStore := httpapi.NewSessionStore()
+	router := httpapi.NewRouter(userService, sessionStore)
+	metrics.RegisterUsersTotal(userService)
+
+	return &Server{
+		httpServer: &http.Server{
+			Addr:         ":8080",
+			Handler:      router,
+			ReadTimeout:  15 * time.Second,
+			WriteTimeout: 15 * time.Second,
+			IdleTimeout:  60 * time.Second,
+		},
+		userService:  userService,
+		sessionStore: sessionStore,
+	}
+}
+
+// Start starts the server
+func (s *Server) Start() error {
+	log.Println("Starting User Management API Server on :8080")
+	return s.httpServer.ListenAndServe()
+}
+
+// Shutdown gracefully shuts down the server, draining in-flight requests and
+// stopping the cleanup task's background goroutine.
+func (s *Server) Shutdown(ctx context.Context) error {
+	log.Println("Shutting down server...")
+	if s.cleanupQuit != nil {
+		close(s.cleanupQuit)
+	}
+	return s.httpServer.Shutdown(ctx)
+}
+
+// StartCleanupTask runs a periodic background cleanup job. The goroutine it
+// starts stops when Shutdown is called.
+func (s *Server) StartCleanupTask() {
+	ticker := time.NewTicker(1 * time.Hour)
+	s.cleanupQuit = make(chan struct{})
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				log.Println("Running cleanup task...")
+				// Perform any necessary cleanup here
+			case <-s.cleanupQuit:
+				return
+			}
+		}
+	}()
+}
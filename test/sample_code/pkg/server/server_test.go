@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"user-management-api/pkg/store/memory"
+)
+
+// TestShutdownDrainsInFlightRequests starts a slow handler, triggers shutdown
+// mid-request, and asserts the response completes before Shutdown returns.
+func TestShutdownDrainsInFlightRequests(t *testing.T) {
+	srv := New(memory.New())
+	srv.httpServer.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(150 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ts := httptest.NewUnstartedServer(nil)
+	ts.Config = srv.httpServer
+	ts.Start()
+	defer ts.Close()
+
+	respErr := make(chan error, 1)
+	go func() {
+		resp, err := http.Get(ts.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		respErr <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond) // let the request start
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+
+	select {
+	case err := <-respErr:
+		if err != nil {
+			t.Fatalf("in-flight request failed: %v", err)
+		}
+	default:
+		t.Fatal("expected in-flight request to complete before Shutdown returned")
+	}
+}
@@ -0,0 +1,190 @@
+// Package memory provides an in-memory implementation of user.Service,
+// useful for local development and tests.
+package memory
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	apierrors "user-management-api/pkg/errors"
+	"user-management-api/pkg/user"
+)
+
+// Store implements user.Service backed by a map guarded by a mutex.
+type Store struct {
+	users map[string]*user.User
+	mu    sync.RWMutex
+}
+
+// New creates a new in-memory user store.
+func New() *Store {
+	return &Store{
+		users: make(map[string]*user.User),
+	}
+}
+
+// CreateUser adds a new user to the store
+func (s *Store) CreateUser(u *user.User) (*user.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := user.Validate(u); err != nil {
+		return nil, err
+	}
+
+	for _, existing := range s.users {
+		if strings.ToLower(existing.Email) == strings.ToLower(u.Email) {
+			return nil, apierrors.ConflictError(fmt.Sprintf("email already exists: %s", u.Email))
+		}
+		if strings.ToLower(existing.Username) == strings.ToLower(u.Username) {
+			return nil, apierrors.ConflictError(fmt.Sprintf("username already exists: %s", u.Username))
+		}
+	}
+
+	if u.Password != "" {
+		hashed, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		u.Password = string(hashed)
+	}
+
+	u.ID = uuid.New().String()
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+	u.IsActive = true
+
+	s.users[u.ID] = u
+	return u, nil
+}
+
+// GetUser retrieves a user by ID, which may be a UUID or a legacy integer ID
+func (s *Store) GetUser(id string) (*user.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, exists := s.users[id]
+	if !exists {
+		return nil, apierrors.NotFoundError(fmt.Sprintf("user not found: %s", id))
+	}
+
+	return u, nil
+}
+
+// FindByUsername looks up a user by their username, used during authentication
+func (s *Store) FindByUsername(username string) (*user.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if strings.EqualFold(u.Username, username) {
+			return u, nil
+		}
+	}
+
+	return nil, apierrors.NotFoundError(fmt.Sprintf("user not found: %s", username))
+}
+
+// UpdateUser updates an existing user
+func (s *Store) UpdateUser(id string, u *user.User) (*user.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, exists := s.users[id]
+	if !exists {
+		return nil, apierrors.NotFoundError(fmt.Sprintf("user not found: %s", id))
+	}
+
+	if err := user.Validate(u); err != nil {
+		return nil, err
+	}
+
+	existing.Username = u.Username
+	existing.Email = u.Email
+	existing.FirstName = u.FirstName
+	existing.LastName = u.LastName
+	existing.Roles = u.Roles
+	existing.IsActive = u.IsActive
+	existing.UpdatedAt = time.Now()
+
+	return existing, nil
+}
+
+// DeleteUser removes a user from the store
+func (s *Store) DeleteUser(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.users[id]; !exists {
+		return apierrors.NotFoundError(fmt.Sprintf("user not found: %s", id))
+	}
+
+	delete(s.users, id)
+	return nil
+}
+
+// ListUsers retrieves users with pagination
+func (s *Store) ListUsers(page, limit int) ([]*user.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*user.User, 0, len(s.users))
+	for _, u := range s.users {
+		all = append(all, u)
+	}
+
+	// Sort by creation time, since IDs are no longer monotonically ordered now
+	// that they're UUIDs.
+	for i := 0; i < len(all); i++ {
+		for j := i + 1; j < len(all); j++ {
+			if all[i].CreatedAt.After(all[j].CreatedAt) {
+				all[i], all[j] = all[j], all[i]
+			}
+		}
+	}
+
+	start := page * limit
+	if start >= len(all) {
+		return []*user.User{}, nil
+	}
+
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+
+	return all[start:end], nil
+}
+
+// SearchUsers searches for users by username, email, or name
+func (s *Store) SearchUsers(query string) ([]*user.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	query = strings.ToLower(query)
+	var results []*user.User
+
+	for _, u := range s.users {
+		if strings.Contains(strings.ToLower(u.Username), query) ||
+			strings.Contains(strings.ToLower(u.Email), query) ||
+			strings.Contains(strings.ToLower(u.FirstName), query) ||
+			strings.Contains(strings.ToLower(u.LastName), query) {
+			results = append(results, u)
+		}
+	}
+
+	return results, nil
+}
+
+// CountUsers reports the current number of users in the store.
+func (s *Store) CountUsers() (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.users), nil
+}
@@ -0,0 +1,261 @@
+// Package postgres provides a database/sql-backed implementation of
+// user.Service on top of PostgreSQL.
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+
+	apierrors "user-management-api/pkg/errors"
+	"user-management-api/pkg/user"
+)
+
+// schema creates the users table if it does not already exist. Run once at
+// startup so the backend can be pointed at a fresh database with no separate
+// migration step.
+const schema = `
+CREATE TABLE IF NOT EXISTS users (
+	id TEXT PRIMARY KEY,
+	username TEXT NOT NULL UNIQUE,
+	email TEXT NOT NULL UNIQUE,
+	password TEXT NOT NULL DEFAULT '',
+	first_name TEXT NOT NULL,
+	last_name TEXT NOT NULL,
+	roles TEXT[] NOT NULL DEFAULT '{}',
+	is_active BOOLEAN NOT NULL DEFAULT true,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+`
+
+// Store implements user.Service backed by a Postgres users table.
+type Store struct {
+	db *sql.DB
+}
+
+// New opens a Postgres-backed store and ensures the users table exists.
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to migrate users table: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// CreateUser inserts a new user row
+func (s *Store) CreateUser(u *user.User) (*user.User, error) {
+	if err := user.Validate(u); err != nil {
+		return nil, err
+	}
+
+	u.ID = uuid.New().String()
+
+	if u.Password != "" {
+		hashed, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		u.Password = string(hashed)
+	}
+
+	row := s.db.QueryRow(
+		`INSERT INTO users (id, username, email, password, first_name, last_name, roles, is_active)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, true)
+		 RETURNING created_at, updated_at, is_active`,
+		u.ID, u.Username, u.Email, u.Password, u.FirstName, u.LastName, pq.Array(u.Roles),
+	)
+
+	if err := row.Scan(&u.CreatedAt, &u.UpdatedAt, &u.IsActive); err != nil {
+		return nil, translateError(err, u)
+	}
+
+	return u, nil
+}
+
+// GetUser retrieves a user by ID, which may be a UUID or a legacy integer ID
+func (s *Store) GetUser(id string) (*user.User, error) {
+	u := &user.User{}
+	err := s.db.QueryRow(
+		`SELECT id, username, email, password, first_name, last_name, roles, is_active, created_at, updated_at
+		 FROM users WHERE id = $1`, id,
+	).Scan(&u.ID, &u.Username, &u.Email, &u.Password, &u.FirstName, &u.LastName, pq.Array(&u.Roles), &u.IsActive, &u.CreatedAt, &u.UpdatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.NotFoundError(fmt.Sprintf("user not found: %s", id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// FindByUsername looks up a user by their username, used during authentication
+func (s *Store) FindByUsername(username string) (*user.User, error) {
+	u := &user.User{}
+	err := s.db.QueryRow(
+		`SELECT id, username, email, password, first_name, last_name, roles, is_active, created_at, updated_at
+		 FROM users WHERE lower(username) = lower($1)`, username,
+	).Scan(&u.ID, &u.Username, &u.Email, &u.Password, &u.FirstName, &u.LastName, pq.Array(&u.Roles), &u.IsActive, &u.CreatedAt, &u.UpdatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.NotFoundError(fmt.Sprintf("user not found: %s", username))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// UpdateUser updates an existing user row
+func (s *Store) UpdateUser(id string, u *user.User) (*user.User, error) {
+	if err := user.Validate(u); err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE users SET username = $1, email = $2, first_name = $3, last_name = $4,
+		 roles = $5, is_active = $6, updated_at = now() WHERE id = $7`,
+		u.Username, u.Email, u.FirstName, u.LastName, pq.Array(u.Roles), u.IsActive, id,
+	)
+	if err != nil {
+		return nil, translateError(err, u)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, apierrors.NotFoundError(fmt.Sprintf("user not found: %s", id))
+	}
+
+	return s.GetUser(id)
+}
+
+// DeleteUser removes a user row
+func (s *Store) DeleteUser(id string) error {
+	result, err := s.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return apierrors.NotFoundError(fmt.Sprintf("user not found: %s", id))
+	}
+
+	return nil
+}
+
+// ListUsers retrieves users with pagination, ordered by ID
+func (s *Store) ListUsers(page, limit int) ([]*user.User, error) {
+	rows, err := s.db.Query(
+		`SELECT id, username, email, password, first_name, last_name, roles, is_active, created_at, updated_at
+		 FROM users ORDER BY id LIMIT $1 OFFSET $2`, limit, page*limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanUsers(rows)
+}
+
+// SearchUsers searches for users by username, email, or name
+func (s *Store) SearchUsers(query string) ([]*user.User, error) {
+	like := "%" + query + "%"
+	rows, err := s.db.Query(
+		`SELECT id, username, email, password, first_name, last_name, roles, is_active, created_at, updated_at
+		 FROM users
+		 WHERE username ILIKE $1 OR email ILIKE $1 OR first_name ILIKE $1 OR last_name ILIKE $1`, like,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanUsers(rows)
+}
+
+// CountUsers reports the current number of user rows.
+func (s *Store) CountUsers() (int, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT count(*) FROM users`).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// MigrateIDs rewrites every legacy integer ID in the users table to a
+// freshly generated UUID, run at startup behind the --migrate-ids flag so
+// operators can move a database seeded before the switch to UUIDs off the
+// old monotonic ID scheme. The in-memory store never carries legacy IDs
+// across a restart, so this only makes sense against Postgres.
+func (s *Store) MigrateIDs() error {
+	rows, err := s.db.Query(`SELECT id FROM users`)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	var legacyIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		if _, err := strconv.Atoi(id); err == nil {
+			legacyIDs = append(legacyIDs, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	for _, id := range legacyIDs {
+		if _, err := s.db.Exec(`UPDATE users SET id = $1 WHERE id = $2`, uuid.New().String(), id); err != nil {
+			return fmt.Errorf("failed to migrate user %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func scanUsers(rows *sql.Rows) ([]*user.User, error) {
+	var results []*user.User
+	for rows.Next() {
+		u := &user.User{}
+		if err := rows.Scan(&u.ID, &u.Username, &u.Email, &u.Password, &u.FirstName, &u.LastName, pq.Array(&u.Roles), &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return nil, err
+		}
+		results = append(results, u)
+	}
+	return results, rows.Err()
+}
+
+// translateError maps Postgres unique-constraint violations onto the same
+// duplicate-email/username errors the in-memory store returns, so callers
+// don't need to know which backend is in use.
+func translateError(err error, u *user.User) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		switch pqErr.Constraint {
+		case "users_email_key":
+			return apierrors.ConflictError(fmt.Sprintf("email already exists: %s", u.Email))
+		case "users_username_key":
+			return apierrors.ConflictError(fmt.Sprintf("username already exists: %s", u.Username))
+		}
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+// Package user defines the core User domain type and the Service
+// contract that every storage backend must satisfy.
+package user
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	apierrors "user-management-api/pkg/errors"
+)
+
+// minUsernameLength is the shortest username CreateUser/UpdateUser accept.
+const minUsernameLength = 3
+
+// User represents a user in the system. ID is a UUIDv4 string; legacy
+// integer IDs minted before the switch to UUIDs remain valid until migrated,
+// see store/postgres.Store.MigrateIDs.
+type User struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Password  string    `json:"-"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	IsActive  bool      `json:"is_active"`
+	Roles     []string  `json:"roles"`
+}
+
+// HasRole reports whether the user has been granted the given role.
+func (u *User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Service is the contract every storage backend implements.
+type Service interface {
+	CreateUser(user *User) (*User, error)
+	GetUser(id string) (*User, error)
+	UpdateUser(id string, user *User) (*User, error)
+	DeleteUser(id string) error
+	ListUsers(page, limit int) ([]*User, error)
+	SearchUsers(query string) ([]*User, error)
+	FindByUsername(username string) (*User, error)
+	CountUsers() (int, error)
+}
+
+// Validate checks the required fields on a user, shared by every backend so
+// they reject the same malformed input the same way. It accumulates every
+// failing field in one pass rather than stopping at the first, so a client
+// gets complete feedback in a single round trip.
+func Validate(u *User) error {
+	var fields []apierrors.FieldError
+
+	username := strings.TrimSpace(u.Username)
+	switch {
+	case username == "":
+		fields = append(fields, apierrors.FieldError{Field: "username", Message: "must not be blank"})
+	case len(username) < minUsernameLength:
+		fields = append(fields, apierrors.FieldError{Field: "username", Message: "must be at least 3 characters"})
+	}
+
+	email := strings.TrimSpace(u.Email)
+	switch {
+	case email == "":
+		fields = append(fields, apierrors.FieldError{Field: "email", Message: "must not be blank"})
+	default:
+		if _, err := mail.ParseAddress(email); err != nil {
+			fields = append(fields, apierrors.FieldError{Field: "email", Message: "must be a valid email address"})
+		}
+	}
+
+	if strings.TrimSpace(u.FirstName) == "" {
+		fields = append(fields, apierrors.FieldError{Field: "first_name", Message: "must not be blank"})
+	}
+
+	if strings.TrimSpace(u.LastName) == "" {
+		fields = append(fields, apierrors.FieldError{Field: "last_name", Message: "must not be blank"})
+	}
+
+	return apierrors.NewValidationErrors(fields)
+}